@@ -474,12 +474,8 @@ func addCmdHeaderHooks(cmds *cobra.Command, kubeConfigFlags *genericclioptions.C
 		crt.ParseCommandHeaders(cmd, args)
 		return existingPreRunE(cmd, args)
 	}
-	wrapConfigFn := kubeConfigFlags.WrapConfigFn
 	// Wraps CommandHeaderRoundTripper around standard RoundTripper.
-	kubeConfigFlags.WrapConfigFn = func(c *rest.Config) *rest.Config {
-		if wrapConfigFn != nil {
-			c = wrapConfigFn(c)
-		}
+	AppendWrapConfigFn(kubeConfigFlags, func(c *rest.Config) *rest.Config {
 		c.Wrap(func(rt http.RoundTripper) http.RoundTripper {
 			// Must be separate RoundTripper; not "crt" closure.
 			// Fixes: https://github.com/kubernetes/kubectl/issues/1098
@@ -489,6 +485,24 @@ func addCmdHeaderHooks(cmds *cobra.Command, kubeConfigFlags *genericclioptions.C
 			}
 		})
 		return c
+	})
+}
+
+// AppendWrapConfigFn chains wrapConfigFn after any WrapConfigFn already set on
+// kubeConfigFlags, rather than replacing it, so that wrappers registered by
+// kubectl and by its embedders are all applied, in registration order.
+// A nil wrapConfigFn is ignored.
+func AppendWrapConfigFn(kubeConfigFlags *genericclioptions.ConfigFlags, wrapConfigFn func(*rest.Config) *rest.Config) {
+	if wrapConfigFn == nil {
+		return
+	}
+	existingWrapConfigFn := kubeConfigFlags.WrapConfigFn
+	if existingWrapConfigFn == nil {
+		kubeConfigFlags.WrapConfigFn = wrapConfigFn
+		return
+	}
+	kubeConfigFlags.WrapConfigFn = func(c *rest.Config) *rest.Config {
+		return wrapConfigFn(existingWrapConfigFn(c))
 	}
 }
 
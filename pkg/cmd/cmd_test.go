@@ -17,8 +17,12 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"net"
+	"net/http"
 	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -26,6 +30,8 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/spf13/cobra"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/rest"
+	"k8s.io/kubectl/pkg/cmd/plugin"
 )
 
 func TestNormalizationFuncGlobalExistence(t *testing.T) {
@@ -151,6 +157,29 @@ func TestKubectlCommandHandlesPlugins(t *testing.T) {
 	}
 }
 
+func TestDefaultPluginHandlerLookupRegisteredPrefix(t *testing.T) {
+	defer func(prefixes []string) { plugin.ValidPluginFilenamePrefixes = prefixes }(plugin.ValidPluginFilenamePrefixes)
+
+	pluginsDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(pluginsDir, "kubectl-ziti-foo"), []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", pluginsDir)
+
+	if _, found := NewDefaultPluginHandler(plugin.ValidPluginFilenamePrefixes).Lookup("foo"); found {
+		t.Fatal("expected kubectl-ziti-foo not to be found without the kubectl-ziti prefix")
+	}
+
+	plugin.RegisterPluginFilenamePrefixes("kubectl-ziti")
+	path, found := NewDefaultPluginHandler(plugin.ValidPluginFilenamePrefixes).Lookup("foo")
+	if !found {
+		t.Fatal("expected kubectl-ziti-foo to be found with the kubectl-ziti prefix registered")
+	}
+	if expected := filepath.Join(pluginsDir, "kubectl-ziti-foo"); path != expected {
+		t.Fatalf("unexpected plugin path: expected %q, got %q", expected, path)
+	}
+}
+
 type testPluginHandler struct {
 	pluginsDirectory string
 
@@ -246,3 +275,59 @@ func TestKubectlCommandHeadersHooks(t *testing.T) {
 		})
 	}
 }
+
+func TestKubectlCommandHeadersHooksPreserveWrapConfigFn(t *testing.T) {
+	t.Setenv(kubectlCmdHeaders, "true")
+	cmds := &cobra.Command{}
+	kubeConfigFlags := genericclioptions.NewConfigFlags(true).WithDeprecatedPasswordFlag()
+	dial := func(ctx context.Context, network, address string) (net.Conn, error) {
+		return nil, fmt.Errorf("not implemented")
+	}
+	kubeConfigFlags.WrapConfigFn = func(c *rest.Config) *rest.Config {
+		c.Dial = dial
+		return c
+	}
+	addCmdHeaderHooks(cmds, kubeConfigFlags)
+
+	c := kubeConfigFlags.WrapConfigFn(&rest.Config{})
+	if c.Dial == nil {
+		t.Error("expected Dial set by the existing WrapConfigFn to be preserved")
+	}
+	if c.WrapTransport == nil {
+		t.Fatal("expected kubectl command headers to wrap the transport")
+	}
+	rt := c.WrapTransport(http.DefaultTransport)
+	if _, ok := rt.(*genericclioptions.CommandHeaderRoundTripper); !ok {
+		t.Errorf("expected CommandHeaderRoundTripper, got %T", rt)
+	}
+}
+
+func TestAppendWrapConfigFn(t *testing.T) {
+	kubeConfigFlags := genericclioptions.NewConfigFlags(true)
+	var calls []string
+	AppendWrapConfigFn(kubeConfigFlags, func(c *rest.Config) *rest.Config {
+		calls = append(calls, "first")
+		c.Host = "https://first"
+		return c
+	})
+	AppendWrapConfigFn(kubeConfigFlags, func(c *rest.Config) *rest.Config {
+		calls = append(calls, "second:"+c.Host)
+		return c
+	})
+
+	AppendWrapConfigFn(kubeConfigFlags, nil)
+
+	kubeConfigFlags.WrapConfigFn(&rest.Config{})
+	expected := []string{"first", "second:https://first"}
+	if !reflect.DeepEqual(expected, calls) {
+		t.Errorf("unexpected wrapper calls: expected %v, got %v", expected, calls)
+	}
+}
+
+func TestAppendWrapConfigFnNil(t *testing.T) {
+	kubeConfigFlags := genericclioptions.NewConfigFlags(true)
+	AppendWrapConfigFn(kubeConfigFlags, nil)
+	if kubeConfigFlags.WrapConfigFn != nil {
+		t.Error("expected a nil wrapper not to set WrapConfigFn")
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -52,12 +53,27 @@ var (
 		Available plugin files are those that are:
 		- executable
 		- anywhere on the user's PATH
-		- begin with "kubectl-"
+		- begin with "kubectl-", or with another registered plugin filename prefix followed by "-"
 `))
 
 	ValidPluginFilenamePrefixes = []string{"kubectl"}
 )
 
+// RegisterPluginFilenamePrefixes appends the given prefixes to
+// ValidPluginFilenamePrefixes, skipping any that are already present.
+// "kubectl plugin list" lists plugins named "<prefix>-*" in a separate
+// section for each registered prefix. When registered before
+// NewDefaultKubectlCommand, a prefix also lets "kubectl foo" resolve to
+// "<prefix>-foo", after every earlier prefix has been tried.
+func RegisterPluginFilenamePrefixes(prefixes ...string) {
+	for _, prefix := range prefixes {
+		if len(prefix) == 0 || containsPrefix(ValidPluginFilenamePrefixes, prefix) {
+			continue
+		}
+		ValidPluginFilenamePrefixes = append(ValidPluginFilenamePrefixes, prefix)
+	}
+}
+
 func NewCmdPlugin(streams genericclioptions.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                   "plugin [flags]",
@@ -116,23 +132,37 @@ func (o *PluginListOptions) Complete(cmd *cobra.Command) error {
 func (o *PluginListOptions) Run() error {
 	plugins, pluginErrors := o.ListPlugins()
 
-	if len(plugins) > 0 {
-		fmt.Fprintf(o.Out, "The following compatible plugins are available:\n\n")
-	} else {
+	if len(plugins) == 0 {
 		pluginErrors = append(pluginErrors, fmt.Errorf("error: unable to find any kubectl plugins in your PATH"))
 	}
 
 	pluginWarnings := 0
-	for _, pluginPath := range plugins {
-		if o.NameOnly {
-			fmt.Fprintf(o.Out, "%s\n", filepath.Base(pluginPath))
+	printedGroups := 0
+	for i, group := range groupPluginsByPrefix(plugins, ValidPluginFilenamePrefixes) {
+		if len(group) == 0 {
+			continue
+		}
+		if printedGroups > 0 {
+			fmt.Fprintln(o.Out)
+		}
+		printedGroups++
+		if i == 0 {
+			fmt.Fprintf(o.Out, "The following compatible plugins are available:\n\n")
 		} else {
-			fmt.Fprintf(o.Out, "%s\n", pluginPath)
+			fmt.Fprintf(o.Out, "The following compatible plugins use the %q prefix:\n\n", ValidPluginFilenamePrefixes[i])
 		}
-		if errs := o.Verifier.Verify(pluginPath); len(errs) != 0 {
-			for _, err := range errs {
-				fmt.Fprintf(o.ErrOut, "  - %s\n", err)
-				pluginWarnings++
+
+		for _, pluginPath := range group {
+			if o.NameOnly {
+				fmt.Fprintf(o.Out, "%s\n", filepath.Base(pluginPath))
+			} else {
+				fmt.Fprintf(o.Out, "%s\n", pluginPath)
+			}
+			if errs := o.Verifier.Verify(pluginPath); len(errs) != 0 {
+				for _, err := range errs {
+					fmt.Fprintf(o.ErrOut, "  - %s\n", err)
+					pluginWarnings++
+				}
 			}
 		}
 	}
@@ -214,12 +244,6 @@ func (v *CommandOverrideVerifier) Verify(path string) []error {
 	segs := strings.Split(path, "/")
 	binName := segs[len(segs)-1]
 
-	cmdPath := strings.Split(binName, "-")
-	if len(cmdPath) > 1 {
-		// the first argument is always "kubectl" for a plugin binary
-		cmdPath = cmdPath[1:]
-	}
-
 	errors := []error{}
 
 	if isExec, err := isExecutable(path); err == nil && !isExec {
@@ -234,11 +258,67 @@ func (v *CommandOverrideVerifier) Verify(path string) []error {
 		v.seenPlugins[binName] = path
 	}
 
-	if cmd, _, err := v.root.Find(cmdPath); err == nil {
-		errors = append(errors, fmt.Errorf("warning: %s overwrites existing command: %q", binName, cmd.CommandPath()))
+	// a plugin answers one command path for each valid prefix it matches,
+	// and is only unreachable if every one of them is taken, either by an
+	// existing command or by a plugin under a prefix that is tried first
+	unreachableErrors := []error{}
+	for _, c := range pluginCommandPaths(binName, ValidPluginFilenamePrefixes) {
+		if cmd, _, err := v.root.Find(strings.Split(c.name, "-")); err == nil {
+			unreachableErrors = append(unreachableErrors, fmt.Errorf("warning: %s overwrites existing command: %q", binName, cmd.CommandPath()))
+			continue
+		}
+		if existingPath, ok := lookupPlugin(c.name, c.precedingPrefixes); ok {
+			unreachableErrors = append(unreachableErrors, fmt.Errorf("warning: %s is overshadowed by a similarly named plugin: %s", path, existingPath))
+			continue
+		}
+		unreachableErrors = nil
+		break
+	}
+
+	return append(errors, unreachableErrors...)
+}
+
+// pluginCommandPath is a "-"-separated command path a plugin can be invoked
+// as, along with the prefixes that are looked up before the one it matched.
+type pluginCommandPath struct {
+	name              string
+	precedingPrefixes []string
+}
+
+// pluginCommandPaths returns the command paths a plugin binary answers, one
+// for each valid prefix it matches, in lookup order. If no prefix matches,
+// the first "-"-separated segment is stripped instead.
+func pluginCommandPaths(binName string, validPrefixes []string) []pluginCommandPath {
+	cmdPaths := []pluginCommandPath{}
+	for i, prefix := range validPrefixes {
+		if strings.HasPrefix(binName, prefix+"-") {
+			cmdPaths = append(cmdPaths, pluginCommandPath{
+				name:              strings.TrimPrefix(binName, prefix+"-"),
+				precedingPrefixes: validPrefixes[:i],
+			})
+		}
+	}
+	if len(cmdPaths) > 0 {
+		return cmdPaths
+	}
+
+	name := binName
+	if i := strings.Index(binName, "-"); i >= 0 {
+		name = binName[i+1:]
 	}
+	return []pluginCommandPath{{name: name}}
+}
 
-	return errors
+// lookupPlugin returns the first plugin on the user's PATH answering the
+// given command path under any of the given prefixes, in order.
+func lookupPlugin(name string, prefixes []string) (string, bool) {
+	for _, prefix := range prefixes {
+		// a path returned alongside exec.ErrDot is still used by the plugin handler
+		if path, _ := exec.LookPath(fmt.Sprintf("%s-%s", prefix, name)); len(path) > 0 {
+			return path, true
+		}
+	}
+	return "", false
 }
 
 func isExecutable(fullPath string) (bool, error) {
@@ -288,3 +368,30 @@ func hasValidPrefix(filepath string, validPrefixes []string) bool {
 	}
 	return false
 }
+
+// groupPluginsByPrefix splits plugin paths into one group per valid prefix,
+// assigning each plugin to the longest prefix its binary name matches.
+// Plugins matching no prefix are kept in the first group.
+func groupPluginsByPrefix(plugins []string, validPrefixes []string) [][]string {
+	groups := make([][]string, len(validPrefixes))
+	for _, pluginPath := range plugins {
+		binName := filepath.Base(pluginPath)
+		matched := 0
+		for i, prefix := range validPrefixes {
+			if len(prefix) > len(validPrefixes[matched]) && strings.HasPrefix(binName, prefix+"-") {
+				matched = i
+			}
+		}
+		groups[matched] = append(groups[matched], pluginPath)
+	}
+	return groups
+}
+
+func containsPrefix(prefixes []string, prefix string) bool {
+	for _, p := range prefixes {
+		if p == prefix {
+			return true
+		}
+	}
+	return false
+}
@@ -24,6 +24,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/spf13/cobra"
+
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 )
 
@@ -235,3 +237,145 @@ func (f *fakePluginPathVerifier) Verify(path string) []error {
 func newFakePluginPathVerifier() *fakePluginPathVerifier {
 	return &fakePluginPathVerifier{seen: make(map[string]bool)}
 }
+
+func TestRegisterPluginFilenamePrefixes(t *testing.T) {
+	defer func(prefixes []string) { ValidPluginFilenamePrefixes = prefixes }(ValidPluginFilenamePrefixes)
+
+	pluginPath := t.TempDir()
+	if err := os.WriteFile(filepath.Join(pluginPath, "zkubectl-foo"), []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	ioStreams, _, _, _ := genericclioptions.NewTestIOStreams()
+	o := &PluginListOptions{
+		Verifier:  newFakePluginPathVerifier(),
+		IOStreams: ioStreams,
+
+		PluginPaths: []string{pluginPath},
+	}
+
+	plugins, errs := o.ListPlugins()
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if len(plugins) > 0 {
+		t.Fatalf("expected no plugins before registering the zkubectl prefix, got %v", plugins)
+	}
+
+	RegisterPluginFilenamePrefixes("zkubectl", "kubectl", "", "zkubectl")
+
+	expectPrefixes := []string{"kubectl", "zkubectl"}
+	if !reflect.DeepEqual(expectPrefixes, ValidPluginFilenamePrefixes) {
+		t.Fatalf("unexpected prefixes. Expecting %v, got %v", expectPrefixes, ValidPluginFilenamePrefixes)
+	}
+
+	plugins, errs = o.ListPlugins()
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	expectPlugins := []string{filepath.Join(pluginPath, "zkubectl-foo")}
+	if !reflect.DeepEqual(expectPlugins, plugins) {
+		t.Fatalf("saw unexpected plugins. Expecting %v, got %v", expectPlugins, plugins)
+	}
+}
+
+func TestCommandOverrideVerifierRegisteredPrefix(t *testing.T) {
+	defer func(prefixes []string) { ValidPluginFilenamePrefixes = prefixes }(ValidPluginFilenamePrefixes)
+	RegisterPluginFilenamePrefixes("kubectl-ziti", "zkubectl")
+
+	pluginPath := t.TempDir()
+	for _, name := range []string{"kubectl-foo", "kubectl-ziti-foo", "kubectl-ziti-get", "zkubectl-foo", "zkubectl-get", "zkubectl-bar"} {
+		if err := os.WriteFile(filepath.Join(pluginPath, name), []byte("#!/bin/sh\n"), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("PATH", pluginPath)
+
+	root := &cobra.Command{Use: "kubectl"}
+	root.AddCommand(&cobra.Command{Use: "get"})
+	verifier := &CommandOverrideVerifier{
+		root:        root,
+		seenPlugins: make(map[string]string),
+	}
+
+	tests := []struct {
+		name      string
+		expectErr string
+	}{
+		{
+			name: "kubectl-foo",
+		},
+		{
+			// still reachable as "kubectl ziti foo"
+			name: "kubectl-ziti-foo",
+		},
+		{
+			// still reachable as "kubectl ziti get"
+			name: "kubectl-ziti-get",
+		},
+		{
+			name:      "zkubectl-foo",
+			expectErr: fmt.Sprintf("warning: %s is overshadowed by a similarly named plugin: %s", filepath.Join(pluginPath, "zkubectl-foo"), filepath.Join(pluginPath, "kubectl-foo")),
+		},
+		{
+			name:      "zkubectl-get",
+			expectErr: `warning: zkubectl-get overwrites existing command: "kubectl get"`,
+		},
+		{
+			name: "zkubectl-bar",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := verifier.Verify(filepath.Join(pluginPath, test.name))
+			if len(test.expectErr) == 0 {
+				if len(errs) > 0 {
+					t.Fatalf("unexpected errors: %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Error() != test.expectErr {
+				t.Fatalf("unexpected errors: expected [%s], got %v", test.expectErr, errs)
+			}
+		})
+	}
+}
+
+func TestListPluginsByRegisteredPrefix(t *testing.T) {
+	defer func(prefixes []string) { ValidPluginFilenamePrefixes = prefixes }(ValidPluginFilenamePrefixes)
+	RegisterPluginFilenamePrefixes("kubectl-ziti")
+
+	pluginPath := t.TempDir()
+	for _, name := range []string{"kubectl-foo", "kubectl-ziti-foo"} {
+		if err := os.WriteFile(filepath.Join(pluginPath, name), []byte("#!/bin/sh\n"), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ioStreams, _, out, _ := genericclioptions.NewTestIOStreams()
+	o := &PluginListOptions{
+		Verifier:  newFakePluginPathVerifier(),
+		NameOnly:  true,
+		IOStreams: ioStreams,
+
+		PluginPaths: []string{pluginPath},
+	}
+
+	if err := o.Run(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectOut := `The following compatible plugins are available:
+
+kubectl-foo
+
+The following compatible plugins use the "kubectl-ziti" prefix:
+
+kubectl-ziti-foo
+`
+	if out.String() != expectOut {
+		t.Fatalf("unexpected output: expected %q, but got %q", expectOut, out.String())
+	}
+}